@@ -0,0 +1,276 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/vmihailenco/msgpack/v5"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Codec marshals and unmarshals values for a single wire format, identified
+// by ContentType, so createHttpHandler can pick one per request instead of
+// the encoding/json it used to hard-code.
+type Codec interface {
+	Marshal(v any) ([]byte, error)
+	Unmarshal(data []byte, v any) error
+	ContentType() string
+}
+
+// JSONCodec is the Codec createHttpHandler used exclusively before codec
+// negotiation was introduced. It is the fallback when NegotiateCodec was not
+// run for a request or was given no codecs; otherwise the fallback is the
+// first codec passed to NegotiateCodec.
+type JSONCodec struct{}
+
+func (JSONCodec) Marshal(v any) ([]byte, error)      { return json.Marshal(v) }
+func (JSONCodec) Unmarshal(data []byte, v any) error { return json.Unmarshal(data, v) }
+func (JSONCodec) ContentType() string                { return "application/json" }
+
+// JSONPBCodec marshals proto.Message values with protojson instead of
+// encoding/json, so generated request/response types with oneofs or
+// well-known types round-trip correctly. It uses its own content type,
+// application/jsonpb, rather than application/json: the two codecs aren't
+// interchangeable (protojson rejects anything that isn't a proto.Message),
+// so callers negotiate into jsonpb explicitly instead of JSONPBCodec
+// silently shadowing plain JSON whenever both are registered.
+type JSONPBCodec struct{}
+
+func (JSONPBCodec) Marshal(v any) ([]byte, error) {
+	msg, ok := v.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("jsonpb: %T does not implement proto.Message", v)
+	}
+	return protojson.Marshal(msg)
+}
+
+func (JSONPBCodec) Unmarshal(data []byte, v any) error {
+	msg, ok := v.(proto.Message)
+	if !ok {
+		return fmt.Errorf("jsonpb: %T does not implement proto.Message", v)
+	}
+	return protojson.Unmarshal(data, msg)
+}
+
+func (JSONPBCodec) ContentType() string { return "application/jsonpb" }
+
+// ProtobufCodec marshals proto.Message values with the binary protobuf wire
+// format.
+type ProtobufCodec struct{}
+
+func (ProtobufCodec) Marshal(v any) ([]byte, error) {
+	msg, ok := v.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("protobuf: %T does not implement proto.Message", v)
+	}
+	return proto.Marshal(msg)
+}
+
+func (ProtobufCodec) Unmarshal(data []byte, v any) error {
+	msg, ok := v.(proto.Message)
+	if !ok {
+		return fmt.Errorf("protobuf: %T does not implement proto.Message", v)
+	}
+	return proto.Unmarshal(data, msg)
+}
+
+func (ProtobufCodec) ContentType() string { return "application/x-protobuf" }
+
+// MsgpackCodec marshals values with MessagePack.
+type MsgpackCodec struct{}
+
+func (MsgpackCodec) Marshal(v any) ([]byte, error)      { return msgpack.Marshal(v) }
+func (MsgpackCodec) Unmarshal(data []byte, v any) error { return msgpack.Unmarshal(data, v) }
+func (MsgpackCodec) ContentType() string                { return "application/x-msgpack" }
+
+// FormCodec marshals a struct's fields as application/x-www-form-urlencoded
+// name=value pairs, reusing the struct's `json` tags for field names rather
+// than introducing a parallel `form` tag.
+type FormCodec struct{}
+
+func (FormCodec) Marshal(v any) ([]byte, error) {
+	values, err := formValues(v)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(values.Encode()), nil
+}
+
+func (FormCodec) Unmarshal(data []byte, v any) error {
+	values, err := url.ParseQuery(string(data))
+	if err != nil {
+		return err
+	}
+	return setFormValues(v, values)
+}
+
+func (FormCodec) ContentType() string { return "application/x-www-form-urlencoded" }
+
+func formValues(v any) (url.Values, error) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("form: %T is not a struct", v)
+	}
+
+	values := url.Values{}
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		name := formFieldName(rt.Field(i))
+		if name == "" {
+			continue
+		}
+		values.Set(name, fmt.Sprintf("%v", rv.Field(i).Interface()))
+	}
+	return values, nil
+}
+
+func setFormValues(v any, values url.Values) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("form: %T is not a pointer to struct", v)
+	}
+	rv = rv.Elem()
+
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		name := formFieldName(rt.Field(i))
+		if name == "" || !values.Has(name) {
+			continue
+		}
+
+		field := rv.Field(i)
+		raw := values.Get(name)
+		switch field.Kind() {
+		case reflect.String:
+			field.SetString(raw)
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			n, err := strconv.ParseInt(raw, 10, 64)
+			if err != nil {
+				return fmt.Errorf("form: field %s: %w", name, err)
+			}
+			field.SetInt(n)
+		default:
+			return fmt.Errorf("form: field %s: unsupported kind %s", name, field.Kind())
+		}
+	}
+	return nil
+}
+
+// formFieldName mirrors encoding/json's tag handling: a field's form name is
+// its `json` tag name if set, "-" to skip the field entirely, or the field's
+// Go name as a fallback.
+func formFieldName(f reflect.StructField) string {
+	tag, ok := f.Tag.Lookup("json")
+	if !ok {
+		return f.Name
+	}
+	name := strings.Split(tag, ",")[0]
+	if name == "-" {
+		return ""
+	}
+	if name == "" {
+		return f.Name
+	}
+	return name
+}
+
+type codecRegistry struct {
+	byContentType map[string]Codec
+	def           Codec
+}
+
+func newCodecRegistry(codecs ...Codec) codecRegistry {
+	reg := codecRegistry{byContentType: make(map[string]Codec, len(codecs)), def: JSONCodec{}}
+	for _, c := range codecs {
+		reg.byContentType[c.ContentType()] = c
+	}
+	if len(codecs) > 0 {
+		reg.def = codecs[0]
+	}
+	return reg
+}
+
+// lookup walks the media types in header in the order they appear and returns
+// the Codec registered for the first one that has one. header may be a
+// Content-Type or an Accept value and may carry multiple, comma-separated
+// media types and ";"-separated parameters (q-values are not weighed). It
+// falls back to reg.def when nothing matches.
+func (reg codecRegistry) lookup(header string) Codec {
+	for _, mediaType := range strings.Split(header, ",") {
+		mediaType = strings.TrimSpace(strings.SplitN(mediaType, ";", 2)[0])
+		if c, ok := reg.byContentType[mediaType]; ok {
+			return c
+		}
+	}
+	return reg.def
+}
+
+type codecContextKey struct{}
+
+type negotiatedCodecs struct {
+	decode Codec
+	encode Codec
+}
+
+// NegotiateCodec returns a RequestFunc, meant to be registered with
+// ServerBefore, that picks a decode Codec from the Content-Type header and an
+// encode Codec from the Accept header, both out of codecs. Each header that
+// matches none of codecs falls back to the first of codecs (JSONCodec if
+// codecs is empty).
+func NegotiateCodec(codecs ...Codec) RequestFunc {
+	registry := newCodecRegistry(codecs...)
+	return func(ctx context.Context, r *http.Request) context.Context {
+		nc := negotiatedCodecs{
+			decode: registry.lookup(r.Header.Get("Content-Type")),
+			encode: registry.lookup(r.Header.Get("Accept")),
+		}
+		return context.WithValue(ctx, codecContextKey{}, nc)
+	}
+}
+
+func codecsFromContext(ctx context.Context) negotiatedCodecs {
+	if nc, ok := ctx.Value(codecContextKey{}).(negotiatedCodecs); ok {
+		return nc
+	}
+	return negotiatedCodecs{decode: JSONCodec{}, encode: JSONCodec{}}
+}
+
+// DecodeWithCodec is a DecodeRequestFunc that unmarshals the request body
+// with the Codec NegotiateCodec picked for it (JSONCodec, if none was
+// negotiated).
+func DecodeWithCodec[Req any](ctx context.Context, r *http.Request) (Req, error) {
+	var request Req
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return request, err
+	}
+	if err := codecsFromContext(ctx).decode.Unmarshal(body, &request); err != nil {
+		return request, err
+	}
+	return request, nil
+}
+
+// EncodeWithCodec is an EncodeResponseFunc that marshals resp with the Codec
+// NegotiateCodec picked for it (JSONCodec, if none was negotiated), setting
+// the response's Content-Type to match.
+func EncodeWithCodec[Resp any](ctx context.Context, w http.ResponseWriter, resp Resp) error {
+	codec := codecsFromContext(ctx).encode
+	body, err := codec.Marshal(resp)
+	if err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", codec.ContentType())
+	_, err = w.Write(body)
+	return err
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestCodecRegistryLookup(t *testing.T) {
+	reg := newCodecRegistry(JSONCodec{}, MsgpackCodec{}, ProtobufCodec{})
+
+	cases := []struct {
+		name   string
+		header string
+		want   Codec
+	}{
+		{"exact match", "application/x-msgpack", MsgpackCodec{}},
+		{"with charset parameter", "application/json; charset=utf-8", JSONCodec{}},
+		{"first of several accepted types", "text/plain, application/x-protobuf", ProtobufCodec{}},
+		{"unknown falls back to default", "text/plain", JSONCodec{}},
+		{"empty header falls back to default", "", JSONCodec{}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := reg.lookup(tc.header); got.ContentType() != tc.want.ContentType() {
+				t.Fatalf("lookup(%q) = %T, want %T", tc.header, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCodecRegistryDefaultIsFirstCodec(t *testing.T) {
+	reg := newCodecRegistry(MsgpackCodec{}, JSONCodec{})
+	if got := reg.lookup("text/plain"); got.ContentType() != (MsgpackCodec{}).ContentType() {
+		t.Fatalf("default = %T, want MsgpackCodec (the first codec passed in)", got)
+	}
+}
+
+func TestJSONCodecAndJSONPBCodecHaveDistinctContentTypes(t *testing.T) {
+	if (JSONCodec{}).ContentType() == (JSONPBCodec{}).ContentType() {
+		t.Fatal("JSONCodec and JSONPBCodec must not share a content type, or one silently shadows the other in a codecRegistry")
+	}
+}
+
+func TestNegotiateCodec(t *testing.T) {
+	before := NegotiateCodec(JSONCodec{}, MsgpackCodec{})
+
+	req := httptest.NewRequest(http.MethodPost, "/count/codec", nil)
+	req.Header.Set("Content-Type", "application/x-msgpack")
+	req.Header.Set("Accept", "application/json")
+
+	ctx := before(req.Context(), req)
+	nc := codecsFromContext(ctx)
+
+	if nc.decode.ContentType() != (MsgpackCodec{}).ContentType() {
+		t.Fatalf("decode codec = %T, want MsgpackCodec (from Content-Type)", nc.decode)
+	}
+	if nc.encode.ContentType() != (JSONCodec{}).ContentType() {
+		t.Fatalf("encode codec = %T, want JSONCodec (from Accept)", nc.encode)
+	}
+}
+
+func TestCodecsFromContextDefaultsToJSON(t *testing.T) {
+	nc := codecsFromContext(httptest.NewRequest(http.MethodGet, "/", nil).Context())
+	if nc.decode.ContentType() != (JSONCodec{}).ContentType() || nc.encode.ContentType() != (JSONCodec{}).ContentType() {
+		t.Fatalf("codecsFromContext without NegotiateCodec = %+v, want JSONCodec for both", nc)
+	}
+}
+
+func TestProtobufCodecRoundTripAgainstRealProtoMessage(t *testing.T) {
+	var codec ProtobufCodec
+
+	body, err := codec.Marshal(wrapperspb.String("hello"))
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+
+	decoded := &wrapperspb.StringValue{}
+	if err := codec.Unmarshal(body, decoded); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if decoded.Value != "hello" {
+		t.Fatalf("decoded.Value = %q, want %q", decoded.Value, "hello")
+	}
+}
+
+func TestProtobufCodecRejectsNonProtoMessage(t *testing.T) {
+	var codec ProtobufCodec
+	if _, err := codec.Marshal(countRequest{S: "hi"}); err == nil {
+		t.Fatal("expected an error marshaling a non-proto.Message value")
+	}
+}
+
+func TestJSONPBCodecRoundTripAgainstRealProtoMessage(t *testing.T) {
+	var codec JSONPBCodec
+
+	body, err := codec.Marshal(wrapperspb.String("hello"))
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+
+	decoded := &wrapperspb.StringValue{}
+	if err := codec.Unmarshal(body, decoded); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if decoded.Value != "hello" {
+		t.Fatalf("decoded.Value = %q, want %q", decoded.Value, "hello")
+	}
+
+	if !proto.Equal(decoded, wrapperspb.String("hello")) {
+		t.Fatalf("decoded %v not equal to original", decoded)
+	}
+}
+
+func TestJSONPBCodecRejectsNonProtoMessage(t *testing.T) {
+	var codec JSONPBCodec
+	if _, err := codec.Marshal(countRequest{S: "hi"}); err == nil {
+		t.Fatal("expected an error marshaling a non-proto.Message value")
+	}
+}
+
+func TestFormCodecRoundTrip(t *testing.T) {
+	var codec FormCodec
+
+	body, err := codec.Marshal(countRequest{S: "hello world"})
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+
+	var decoded countRequest
+	if err := codec.Unmarshal(body, &decoded); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if decoded.S != "hello world" {
+		t.Fatalf("decoded.S = %q, want %q", decoded.S, "hello world")
+	}
+}
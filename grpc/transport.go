@@ -0,0 +1,77 @@
+// Package grpc adapts the generic Endpoint/Middleware machinery in the root
+// package to gRPC, the same way createHttpHandler in the root package adapts
+// it to net/http. It knows nothing about any particular service; DecodeGRPCRequestFunc
+// and EncodeGRPCResponseFunc bridge a generated gRPC message type to the
+// domain Req/Resp types an Endpoint[Req, Resp] operates on.
+package grpc
+
+import "context"
+
+// DecodeGRPCRequestFunc extracts a user-domain request from a generated gRPC
+// request message.
+type DecodeGRPCRequestFunc[Req any, GReq any] func(context.Context, GReq) (Req, error)
+
+// EncodeGRPCResponseFunc encodes a user-domain response into a generated gRPC
+// response message.
+type EncodeGRPCResponseFunc[Resp any, GResp any] func(context.Context, Resp) (GResp, error)
+
+// EncodeGRPCRequestFunc encodes a user-domain request into a generated gRPC
+// request message, for use on the client side.
+type EncodeGRPCRequestFunc[Req any, GReq any] func(context.Context, Req) (GReq, error)
+
+// DecodeGRPCResponseFunc extracts a user-domain response from a generated
+// gRPC response message, for use on the client side.
+type DecodeGRPCResponseFunc[Resp any, GResp any] func(context.Context, GResp) (Resp, error)
+
+type grpcServer[Req any, Resp any, GReq any, GResp any] struct {
+	e   func(context.Context, Req) (Resp, error)
+	dec DecodeGRPCRequestFunc[Req, GReq]
+	enc EncodeGRPCResponseFunc[Resp, GResp]
+}
+
+// NewGRPCServer binds an Endpoint[Req, Resp] (and any middlewares chained
+// onto it) to a generated gRPC method signature, so it can be registered
+// against a grpc.Server alongside createHttpHandler serving the same logic.
+func NewGRPCServer[Req any, Resp any, GReq any, GResp any](
+	e func(context.Context, Req) (Resp, error),
+	dec DecodeGRPCRequestFunc[Req, GReq],
+	enc EncodeGRPCResponseFunc[Resp, GResp],
+) func(context.Context, GReq) (GResp, error) {
+	s := grpcServer[Req, Resp, GReq, GResp]{e: e, dec: dec, enc: enc}
+	return s.serve
+}
+
+func (s grpcServer[Req, Resp, GReq, GResp]) serve(ctx context.Context, req GReq) (GResp, error) {
+	var zero GResp
+	request, err := s.dec(ctx, req)
+	if err != nil {
+		return zero, err
+	}
+	response, err := s.e(ctx, request)
+	if err != nil {
+		return zero, err
+	}
+	return s.enc(ctx, response)
+}
+
+// NewGRPCClient returns an Endpoint-shaped function that invokes a remote
+// gRPC method through call (typically a generated client's method value), so
+// client-side middlewares compose identically to server-side ones.
+func NewGRPCClient[Req any, Resp any, GReq any, GResp any](
+	call func(context.Context, GReq) (GResp, error),
+	enc EncodeGRPCRequestFunc[Req, GReq],
+	dec DecodeGRPCResponseFunc[Resp, GResp],
+) func(context.Context, Req) (Resp, error) {
+	return func(ctx context.Context, request Req) (Resp, error) {
+		var zero Resp
+		greq, err := enc(ctx, request)
+		if err != nil {
+			return zero, err
+		}
+		gresp, err := call(ctx, greq)
+		if err != nil {
+			return zero, err
+		}
+		return dec(ctx, gresp)
+	}
+}
@@ -0,0 +1,104 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/spachava753/go-kit-generic/grpc/pb"
+)
+
+// domain request/response types standing in for the root package's
+// uppercaseRequest/uppercaseResponse, so this package can test NewGRPCServer
+// and NewGRPCClient without importing the root package (which itself
+// imports this one).
+type uppercaseRequest struct{ s string }
+type uppercaseResponse struct{ v, err string }
+
+type stringServiceServer struct {
+	pb.UnimplementedStringServiceServer
+	uppercase func(context.Context, *pb.UppercaseRequest) (*pb.UppercaseReply, error)
+}
+
+func (s stringServiceServer) Uppercase(ctx context.Context, req *pb.UppercaseRequest) (*pb.UppercaseReply, error) {
+	return s.uppercase(ctx, req)
+}
+
+func TestNewGRPCServerAndNewGRPCClientRoundTrip(t *testing.T) {
+	endpoint := NewGRPCServer[uppercaseRequest, uppercaseResponse, *pb.UppercaseRequest, *pb.UppercaseReply](
+		func(_ context.Context, req uppercaseRequest) (uppercaseResponse, error) {
+			if req.s == "" {
+				return uppercaseResponse{}, errors.New("empty string")
+			}
+			return uppercaseResponse{v: req.s + "!"}, nil
+		},
+		func(_ context.Context, r *pb.UppercaseRequest) (uppercaseRequest, error) {
+			return uppercaseRequest{s: r.S}, nil
+		},
+		func(_ context.Context, r uppercaseResponse) (*pb.UppercaseReply, error) {
+			return &pb.UppercaseReply{V: r.v, Err: r.err}, nil
+		},
+	)
+
+	server := grpc.NewServer()
+	pb.RegisterStringServiceServer(server, stringServiceServer{uppercase: endpoint})
+
+	listener := bufconn.Listen(1024 * 1024)
+	go server.Serve(listener)
+	defer server.Stop()
+
+	cc, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("Dial: %v", err)
+	}
+	defer cc.Close()
+
+	client := pb.NewStringServiceClient(cc)
+	clientEndpoint := NewGRPCClient[uppercaseRequest, uppercaseResponse, *pb.UppercaseRequest, *pb.UppercaseReply](
+		func(ctx context.Context, req *pb.UppercaseRequest) (*pb.UppercaseReply, error) {
+			return client.Uppercase(ctx, req)
+		},
+		func(_ context.Context, req uppercaseRequest) (*pb.UppercaseRequest, error) {
+			return &pb.UppercaseRequest{S: req.s}, nil
+		},
+		func(_ context.Context, r *pb.UppercaseReply) (uppercaseResponse, error) {
+			return uppercaseResponse{v: r.V, err: r.Err}, nil
+		},
+	)
+
+	resp, err := clientEndpoint(context.Background(), uppercaseRequest{s: "hi"})
+	if err != nil {
+		t.Fatalf("err = %v, want nil", err)
+	}
+	if resp.v != "hi!" {
+		t.Fatalf("resp.v = %q, want %q", resp.v, "hi!")
+	}
+}
+
+func TestNewGRPCClientPropagatesTransportError(t *testing.T) {
+	clientEndpoint := NewGRPCClient[uppercaseRequest, uppercaseResponse, *pb.UppercaseRequest, *pb.UppercaseReply](
+		func(context.Context, *pb.UppercaseRequest) (*pb.UppercaseReply, error) {
+			return nil, errors.New("unavailable")
+		},
+		func(_ context.Context, req uppercaseRequest) (*pb.UppercaseRequest, error) {
+			return &pb.UppercaseRequest{S: req.s}, nil
+		},
+		func(_ context.Context, r *pb.UppercaseReply) (uppercaseResponse, error) {
+			return uppercaseResponse{v: r.V, err: r.Err}, nil
+		},
+	)
+
+	if _, err := clientEndpoint(context.Background(), uppercaseRequest{s: "hi"}); err == nil {
+		t.Fatal("err = nil, want the call's transport error propagated")
+	}
+}
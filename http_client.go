@@ -0,0 +1,94 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// EncodeRequestFunc encodes a user-domain request into an outgoing HTTP
+// request, the client-side mirror of DecodeRequestFunc.
+type EncodeRequestFunc[Req any] func(context.Context, *http.Request, Req) error
+
+// DecodeResponseFunc extracts a user-domain response from an HTTP response,
+// the client-side mirror of EncodeResponseFunc.
+type DecodeResponseFunc[Resp any] func(context.Context, *http.Response) (Resp, error)
+
+// ClientErrorDecoder turns a non-2xx HTTP response into an error, the
+// client-side mirror of ErrorEncoder.
+type ClientErrorDecoder func(context.Context, *http.Response) error
+
+// ClientOption customizes a client-side Endpoint built by NewHTTPClient.
+type ClientOption func(*clientOptions)
+
+type clientOptions struct {
+	client       *http.Client
+	errorDecoder ClientErrorDecoder
+}
+
+// ClientHTTPClient sets the *http.Client used to make requests, overriding
+// http.DefaultClient.
+func ClientHTTPClient(client *http.Client) ClientOption {
+	return func(o *clientOptions) { o.client = client }
+}
+
+// ClientErrorDecoderOption overrides how a non-2xx response is turned into an
+// error, in place of defaultErrorDecoder.
+func ClientErrorDecoderOption(dec ClientErrorDecoder) ClientOption {
+	return func(o *clientOptions) { o.errorDecoder = dec }
+}
+
+// defaultErrorDecoder reads the {"error": "..."} JSON body written by
+// defaultErrorEncoder and returns its message as an error. It falls back to
+// the bare status code if the body isn't in that shape, so a non-2xx
+// response is never mistaken for success and decoded as a zero-value Resp.
+func defaultErrorDecoder(_ context.Context, r *http.Response) error {
+	var body struct {
+		Error string `json:"error"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.Error == "" {
+		return fmt.Errorf("unexpected status code %d", r.StatusCode)
+	}
+	return errors.New(body.Error)
+}
+
+// NewHTTPClient builds an Endpoint[Req, Resp] that calls a remote HTTP
+// endpoint, so client-side middlewares (retry, circuit-breaker, logging) can
+// be chained onto it exactly like their server-side counterparts.
+func NewHTTPClient[Req any, Resp any](
+	method, url string,
+	enc EncodeRequestFunc[Req],
+	dec DecodeResponseFunc[Resp],
+	opts ...ClientOption,
+) Endpoint[Req, Resp] {
+	options := clientOptions{client: http.DefaultClient, errorDecoder: defaultErrorDecoder}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	return func(ctx context.Context, request Req) (Resp, error) {
+		var zero Resp
+
+		req, err := http.NewRequestWithContext(ctx, method, url, nil)
+		if err != nil {
+			return zero, err
+		}
+		if err := enc(ctx, req, request); err != nil {
+			return zero, err
+		}
+
+		resp, err := options.client.Do(req)
+		if err != nil {
+			return zero, err
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode < 200 || resp.StatusCode > 299 {
+			return zero, options.errorDecoder(ctx, resp)
+		}
+
+		return dec(ctx, resp)
+	}
+}
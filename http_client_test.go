@@ -0,0 +1,108 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNewHTTPClientDecodesSuccess(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(countResponse{V: 5})
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient[countRequest, countResponse](
+		http.MethodPost, server.URL,
+		encodeCountHTTPRequest,
+		decodeCountHTTPResponse,
+	)
+
+	resp, err := client(context.Background(), countRequest{S: "hello"})
+	if err != nil {
+		t.Fatalf("err = %v, want nil", err)
+	}
+	if resp.V != 5 {
+		t.Fatalf("resp.V = %d, want 5", resp.V)
+	}
+}
+
+func TestNewHTTPClientReturnsErrorOnNon2xx(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defaultErrorEncoder(r.Context(), ErrEmpty, w)
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient[countRequest, countResponse](
+		http.MethodPost, server.URL,
+		encodeCountHTTPRequest,
+		decodeCountHTTPResponse,
+	)
+
+	resp, err := client(context.Background(), countRequest{S: ""})
+	if err == nil {
+		t.Fatal("err = nil, want an error decoded from the 500 response")
+	}
+	if err.Error() != ErrEmpty.Error() {
+		t.Fatalf("err = %q, want %q", err.Error(), ErrEmpty.Error())
+	}
+	if resp != (countResponse{}) {
+		t.Fatalf("resp = %+v, want the zero value", resp)
+	}
+}
+
+func TestNewHTTPClientErrorDecoderOptionOverridesDefault(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	}))
+	defer server.Close()
+
+	var gotStatus int
+	client := NewHTTPClient[countRequest, countResponse](
+		http.MethodPost, server.URL,
+		encodeCountHTTPRequest,
+		decodeCountHTTPResponse,
+		ClientErrorDecoderOption(func(_ context.Context, r *http.Response) error {
+			gotStatus = r.StatusCode
+			return ErrEmpty
+		}),
+	)
+
+	if _, err := client(context.Background(), countRequest{}); err != ErrEmpty {
+		t.Fatalf("err = %v, want %v", err, ErrEmpty)
+	}
+	if gotStatus != http.StatusTeapot {
+		t.Fatalf("gotStatus = %d, want %d", gotStatus, http.StatusTeapot)
+	}
+}
+
+func TestMakeClientEndpointsUppercaseRoundTrip(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/uppercase", func(w http.ResponseWriter, r *http.Request) {
+		var req uppercaseRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		if req.S == "" {
+			defaultErrorEncoder(r.Context(), ErrEmpty, w)
+			return
+		}
+		json.NewEncoder(w).Encode(uppercaseResponse{V: req.S + "!"})
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	endpoints := MakeClientEndpoints(server.URL)
+
+	resp, err := endpoints.UppercaseEndpoint(context.Background(), uppercaseRequest{S: "hi"})
+	if err != nil {
+		t.Fatalf("err = %v, want nil", err)
+	}
+	if resp.V != "hi!" {
+		t.Fatalf("resp.V = %q, want %q", resp.V, "hi!")
+	}
+
+	if _, err := endpoints.UppercaseEndpoint(context.Background(), uppercaseRequest{S: ""}); err == nil {
+		t.Fatal("err = nil, want the server's 500 decoded as an error")
+	}
+}
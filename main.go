@@ -6,8 +6,16 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"net"
 	"net/http"
 	"strings"
+
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/sony/gobreaker"
+	"google.golang.org/grpc"
+
+	grpctransport "github.com/spachava753/go-kit-generic/grpc"
+	"github.com/spachava753/go-kit-generic/grpc/pb"
 )
 
 type StringService interface {
@@ -133,14 +141,57 @@ func encodeCountResponse(_ context.Context, w http.ResponseWriter, response coun
 	return json.NewEncoder(w).Encode(response)
 }
 
+func decodeUppercasePathRequest(ctx context.Context, _ *http.Request) (uppercaseRequest, error) {
+	return uppercaseRequest{S: PathParams(ctx)["s"]}, nil
+}
+
+func decodeGRPCUppercaseRequest(_ context.Context, r *pb.UppercaseRequest) (uppercaseRequest, error) {
+	return uppercaseRequest{S: r.S}, nil
+}
+
+func encodeGRPCUppercaseResponse(_ context.Context, r uppercaseResponse) (*pb.UppercaseReply, error) {
+	return &pb.UppercaseReply{V: r.V, Err: r.Err}, nil
+}
+
+func decodeGRPCCountRequest(_ context.Context, r *pb.CountRequest) (countRequest, error) {
+	return countRequest{S: r.S}, nil
+}
+
+func encodeGRPCCountResponse(_ context.Context, r countResponse) (*pb.CountReply, error) {
+	return &pb.CountReply{V: int64(r.V)}, nil
+}
+
+// grpcBinding implements pb.StringServiceServer by delegating each method to
+// a gRPC-bound Endpoint, so the same endpoint (and its middlewares) answers
+// both the HTTP and gRPC transports.
+type grpcBinding struct {
+	pb.UnimplementedStringServiceServer
+	uppercase func(context.Context, *pb.UppercaseRequest) (*pb.UppercaseReply, error)
+	count     func(context.Context, *pb.CountRequest) (*pb.CountReply, error)
+}
+
+func (b grpcBinding) Uppercase(ctx context.Context, req *pb.UppercaseRequest) (*pb.UppercaseReply, error) {
+	return b.uppercase(ctx, req)
+}
+
+func (b grpcBinding) Count(ctx context.Context, req *pb.CountRequest) (*pb.CountReply, error) {
+	return b.count(ctx, req)
+}
+
 func main() {
 	svc := stringService{}
 
+	requestCount := discard.NewCounter()
+	requestLatency := discard.NewHistogram()
+	uppercaseBreaker := gobreaker.NewCircuitBreaker(gobreaker.Settings{Name: "uppercase"})
+
 	uppercaseEndpoint := Chain[uppercaseRequest, uppercaseResponse](
 		annotate[uppercaseRequest, uppercaseResponse]("first"),
 		annotate[uppercaseRequest, uppercaseResponse]("second"),
 		annotate[uppercaseRequest, uppercaseResponse]("third"),
 		logIt[uppercaseRequest, uppercaseResponse](),
+		InstrumentingMiddleware[uppercaseRequest, uppercaseResponse](requestCount, requestLatency),
+		CircuitBreakerMiddleware[uppercaseRequest, uppercaseResponse](uppercaseBreaker),
 	)(makeUppercaseEndpoint(svc))
 	createHttpHandler("/uppercase", uppercaseEndpoint,
 		decodeUppercaseRequest,
@@ -148,29 +199,54 @@ func main() {
 	createHttpHandler("/count", makeCountEndpoint(svc),
 		decodeCountRequest,
 		encodeCountResponse)
+	createRoutedHandler(Route[uppercaseRequest, uppercaseResponse]{
+		Methods:  []string{http.MethodGet},
+		Path:     "/uppercase/{s}",
+		Endpoint: uppercaseEndpoint,
+		Decode:   decodeUppercasePathRequest,
+		Encode:   encodeUppercaseResponse,
+	})
+	createHttpHandler("/count/codec", makeCountEndpoint(svc),
+		DecodeWithCodec[countRequest],
+		EncodeWithCodec[countResponse],
+		// ProtobufCodec and JSONPBCodec are deliberately left out here: they
+		// only marshal proto.Message values, and countRequest/countResponse
+		// are plain structs. See codec_test.go for coverage of those two
+		// codecs against a real proto.Message.
+		ServerBefore(NegotiateCodec(JSONCodec{}, MsgpackCodec{}, FormCodec{})),
+	)
+
+	binding := grpcBinding{
+		uppercase: grpctransport.NewGRPCServer[uppercaseRequest, uppercaseResponse, *pb.UppercaseRequest, *pb.UppercaseReply](
+			uppercaseEndpoint,
+			decodeGRPCUppercaseRequest,
+			encodeGRPCUppercaseResponse,
+		),
+		count: grpctransport.NewGRPCServer[countRequest, countResponse, *pb.CountRequest, *pb.CountReply](
+			makeCountEndpoint(svc),
+			decodeGRPCCountRequest,
+			encodeGRPCCountResponse,
+		),
+	}
+	grpcServer := grpc.NewServer()
+	pb.RegisterStringServiceServer(grpcServer, binding)
+	grpcListener, err := net.Listen("tcp", ":8081")
+	if err != nil {
+		log.Fatal(err)
+	}
+	go grpcServer.Serve(grpcListener)
+
 	log.Fatal(http.ListenAndServe(":8080", nil))
 }
 
 func createHttpHandler[Req any, Resp any](path string, e Endpoint[Req, Resp],
 	dec DecodeRequestFunc[Req],
-	enc EncodeResponseFunc[Resp]) {
-	http.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
-		ctx := r.Context()
-		request, err := dec(ctx, r)
-		if err != nil {
-			w.Write([]byte(fmt.Sprintf("err: %s", err)))
-			return
-		}
-
-		response, err := e(ctx, request)
-		if err != nil {
-			w.Write([]byte(fmt.Sprintf("err: %s", err)))
-			return
-		}
+	enc EncodeResponseFunc[Resp],
+	opts ...ServerOption) {
+	options := serverOptions{errorEncoder: defaultErrorEncoder}
+	for _, opt := range opts {
+		opt(&options)
+	}
 
-		if writeErr := enc(ctx, w, response); writeErr != nil {
-			w.Write([]byte(fmt.Sprintf("err: %s", writeErr)))
-			return
-		}
-	})
+	http.HandleFunc(path, serveEndpoint(options, e, dec, enc))
 }
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/go-kit/kit/metrics"
+	"github.com/sony/gobreaker"
+)
+
+// InstrumentingMiddleware records a request count and a latency observation
+// for every call, each labeled by whether the call succeeded, so a Counter
+// and Histogram backed by Prometheus, StatsD, etc. can be plugged in without
+// the endpoint itself knowing about metrics.
+func InstrumentingMiddleware[Req any, Resp any](counter metrics.Counter, histogram metrics.Histogram) Middleware[Req, Resp] {
+	return func(next Endpoint[Req, Resp]) Endpoint[Req, Resp] {
+		return func(ctx context.Context, request Req) (response Resp, err error) {
+			defer func(begin time.Time) {
+				lvs := []string{"success", strconv.FormatBool(err == nil)}
+				counter.With(lvs...).Add(1)
+				histogram.With(lvs...).Observe(time.Since(begin).Seconds())
+			}(time.Now())
+			return next(ctx, request)
+		}
+	}
+}
+
+// CircuitBreakerMiddleware routes calls through a gobreaker.CircuitBreaker,
+// so repeated endpoint failures trip the breaker and fail fast instead of
+// piling up latency on a downstream dependency that is already struggling.
+func CircuitBreakerMiddleware[Req any, Resp any](cb *gobreaker.CircuitBreaker) Middleware[Req, Resp] {
+	return func(next Endpoint[Req, Resp]) Endpoint[Req, Resp] {
+		return func(ctx context.Context, request Req) (Resp, error) {
+			resp, err := cb.Execute(func() (interface{}, error) {
+				return next(ctx, request)
+			})
+			if err != nil {
+				var zero Resp
+				return zero, err
+			}
+			return resp.(Resp), nil
+		}
+	}
+}
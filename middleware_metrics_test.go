@@ -0,0 +1,144 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-kit/kit/metrics"
+	"github.com/sony/gobreaker"
+)
+
+// fakeCounter and fakeLabeledCounter record every With/Add call so a test can
+// assert on the labels InstrumentingMiddleware attaches, which metrics/discard
+// (a no-op sink) can't provide.
+type fakeCounter struct {
+	adds []labeledValue
+}
+
+type labeledValue struct {
+	labels []string
+	value  float64
+}
+
+func (c *fakeCounter) With(labelValues ...string) metrics.Counter {
+	return &fakeLabeledCounter{counter: c, labels: append([]string(nil), labelValues...)}
+}
+
+func (c *fakeCounter) Add(delta float64) {
+	c.adds = append(c.adds, labeledValue{value: delta})
+}
+
+type fakeLabeledCounter struct {
+	counter *fakeCounter
+	labels  []string
+}
+
+func (l *fakeLabeledCounter) With(labelValues ...string) metrics.Counter {
+	return &fakeLabeledCounter{counter: l.counter, labels: append(append([]string(nil), l.labels...), labelValues...)}
+}
+
+func (l *fakeLabeledCounter) Add(delta float64) {
+	l.counter.adds = append(l.counter.adds, labeledValue{labels: l.labels, value: delta})
+}
+
+// fakeHistogram mirrors fakeCounter but for Observe calls.
+type fakeHistogram struct {
+	observations []labeledValue
+}
+
+func (h *fakeHistogram) With(labelValues ...string) metrics.Histogram {
+	return &fakeLabeledHistogram{histogram: h, labels: append([]string(nil), labelValues...)}
+}
+
+func (h *fakeHistogram) Observe(value float64) {
+	h.observations = append(h.observations, labeledValue{value: value})
+}
+
+type fakeLabeledHistogram struct {
+	histogram *fakeHistogram
+	labels    []string
+}
+
+func (l *fakeLabeledHistogram) With(labelValues ...string) metrics.Histogram {
+	return &fakeLabeledHistogram{histogram: l.histogram, labels: append(append([]string(nil), l.labels...), labelValues...)}
+}
+
+func (l *fakeLabeledHistogram) Observe(value float64) {
+	l.histogram.observations = append(l.histogram.observations, labeledValue{labels: l.labels, value: value})
+}
+
+func TestInstrumentingMiddlewareLabelsSuccessAndFailure(t *testing.T) {
+	counter := &fakeCounter{}
+	histogram := &fakeHistogram{}
+
+	failing := errors.New("boom")
+	endpoint := InstrumentingMiddleware[countRequest, countResponse](counter, histogram)(
+		func(_ context.Context, request countRequest) (countResponse, error) {
+			if request.S == "fail" {
+				return countResponse{}, failing
+			}
+			return countResponse{V: len(request.S)}, nil
+		},
+	)
+
+	if _, err := endpoint(context.Background(), countRequest{S: "fail"}); err != failing {
+		t.Fatalf("err = %v, want %v", err, failing)
+	}
+	if _, err := endpoint(context.Background(), countRequest{S: "ok"}); err != nil {
+		t.Fatalf("err = %v, want nil", err)
+	}
+
+	if len(counter.adds) != 2 {
+		t.Fatalf("counter.adds = %v, want 2 entries", counter.adds)
+	}
+	if got := counter.adds[0].labels; len(got) != 2 || got[0] != "success" || got[1] != "false" {
+		t.Fatalf("first call labels = %v, want [success false]", got)
+	}
+	if got := counter.adds[1].labels; len(got) != 2 || got[0] != "success" || got[1] != "true" {
+		t.Fatalf("second call labels = %v, want [success true]", got)
+	}
+
+	if len(histogram.observations) != 2 {
+		t.Fatalf("histogram.observations = %v, want 2 entries", histogram.observations)
+	}
+	if got := histogram.observations[0].labels; len(got) != 2 || got[0] != "success" || got[1] != "false" {
+		t.Fatalf("first observation labels = %v, want [success false]", got)
+	}
+}
+
+func TestCircuitBreakerMiddlewareTripsAfterRepeatedFailures(t *testing.T) {
+	failing := errors.New("downstream unavailable")
+	var calls int
+	cb := gobreaker.NewCircuitBreaker(gobreaker.Settings{
+		Name: "test",
+		ReadyToTrip: func(counts gobreaker.Counts) bool {
+			return counts.ConsecutiveFailures >= 3
+		},
+	})
+
+	endpoint := CircuitBreakerMiddleware[countRequest, countResponse](cb)(
+		func(_ context.Context, _ countRequest) (countResponse, error) {
+			calls++
+			return countResponse{}, failing
+		},
+	)
+
+	for i := 0; i < 3; i++ {
+		if _, err := endpoint(context.Background(), countRequest{}); err != failing {
+			t.Fatalf("call %d: err = %v, want %v", i, err, failing)
+		}
+	}
+	if calls != 3 {
+		t.Fatalf("calls = %d, want 3", calls)
+	}
+
+	// The breaker should now be open and fail fast without invoking the
+	// endpoint at all.
+	if _, err := endpoint(context.Background(), countRequest{}); err != gobreaker.ErrOpenState {
+		t.Fatalf("err = %v, want %v", err, gobreaker.ErrOpenState)
+	}
+	if calls != 3 {
+		t.Fatalf("calls = %d after breaker tripped, want 3 (endpoint must not run)", calls)
+	}
+}
@@ -0,0 +1,131 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// pathParamsKey is the context key under which createRoutedHandler stores
+// the path parameters extracted from a matched route.
+type pathParamsKey struct{}
+
+// PathParams returns the path parameters extracted from the request's route,
+// keyed by the {name} segments in the route's Path, so a decoder can pull
+// values out of the URL instead of requiring a JSON body.
+func PathParams(ctx context.Context) map[string]string {
+	params, _ := ctx.Value(pathParamsKey{}).(map[string]string)
+	return params
+}
+
+// Route describes one REST-style endpoint: the HTTP methods it answers to, a
+// path pattern with optional {name} segments (e.g. "/uppercase/{s}"), and the
+// decode/endpoint/encode triple createHttpHandler already uses.
+type Route[Req any, Resp any] struct {
+	Methods  []string
+	Path     string
+	Endpoint Endpoint[Req, Resp]
+	Decode   DecodeRequestFunc[Req]
+	Encode   EncodeResponseFunc[Resp]
+}
+
+// createRoutedHandler registers a Route against the default mux, matching
+// requests by HTTP method and a compiled regex built from Path. This unlocks
+// building REST services, not just RPC-over-POST, with the same
+// Endpoint[Req, Resp] core createHttpHandler uses.
+func createRoutedHandler[Req any, Resp any](route Route[Req, Resp], opts ...ServerOption) {
+	options := serverOptions{errorEncoder: defaultErrorEncoder}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	pattern, names := compileRoutePattern(route.Path)
+	methods := make(map[string]struct{}, len(route.Methods))
+	for _, m := range route.Methods {
+		methods[m] = struct{}{}
+	}
+
+	registerRoute(routeEntry{
+		methods: methods,
+		pattern: pattern,
+		names:   names,
+		handler: serveEndpoint(options, route.Endpoint, route.Decode, route.Encode),
+	})
+}
+
+var routeParamPattern = regexp.MustCompile(`\{([^{}]+)\}`)
+
+// compileRoutePattern turns a path like "/uppercase/{s}" into a regex that
+// anchors the whole path and captures one group per {name} segment, in the
+// order the names appear.
+func compileRoutePattern(path string) (*regexp.Regexp, []string) {
+	var (
+		b     strings.Builder
+		names []string
+		rest  = path
+	)
+	b.WriteString("^")
+	for {
+		loc := routeParamPattern.FindStringSubmatchIndex(rest)
+		if loc == nil {
+			b.WriteString(regexp.QuoteMeta(rest))
+			break
+		}
+		b.WriteString(regexp.QuoteMeta(rest[:loc[0]]))
+		names = append(names, rest[loc[2]:loc[3]])
+		b.WriteString(`([^/]+)`)
+		rest = rest[loc[1]:]
+	}
+	b.WriteString("$")
+	return regexp.MustCompile(b.String()), names
+}
+
+type routeEntry struct {
+	methods map[string]struct{}
+	pattern *regexp.Regexp
+	names   []string
+	handler http.HandlerFunc
+}
+
+var (
+	routesOnce sync.Once
+	routeMu    sync.Mutex
+	routeTable []routeEntry
+)
+
+func registerRoute(route routeEntry) {
+	routesOnce.Do(func() {
+		http.HandleFunc("/", dispatchRoutes)
+	})
+
+	routeMu.Lock()
+	defer routeMu.Unlock()
+	routeTable = append(routeTable, route)
+}
+
+func dispatchRoutes(w http.ResponseWriter, r *http.Request) {
+	routeMu.Lock()
+	table := routeTable
+	routeMu.Unlock()
+
+	for _, route := range table {
+		if _, ok := route.methods[r.Method]; !ok {
+			continue
+		}
+		match := route.pattern.FindStringSubmatch(r.URL.Path)
+		if match == nil {
+			continue
+		}
+
+		params := make(map[string]string, len(route.names))
+		for i, name := range route.names {
+			params[name] = match[i+1]
+		}
+		ctx := context.WithValue(r.Context(), pathParamsKey{}, params)
+		route.handler(w, r.WithContext(ctx))
+		return
+	}
+	http.NotFound(w, r)
+}
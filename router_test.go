@@ -0,0 +1,124 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCompileRoutePattern(t *testing.T) {
+	pattern, names := compileRoutePattern("/uppercase/{s}")
+
+	if got, want := names, []string{"s"}; len(got) != len(want) || got[0] != want[0] {
+		t.Fatalf("names = %v, want %v", got, want)
+	}
+
+	match := pattern.FindStringSubmatch("/uppercase/hello")
+	if match == nil {
+		t.Fatal("expected /uppercase/hello to match")
+	}
+	if match[1] != "hello" {
+		t.Fatalf("captured %q, want %q", match[1], "hello")
+	}
+
+	if pattern.MatchString("/uppercase/hello/world") {
+		t.Fatal("pattern should not match extra path segments")
+	}
+	if pattern.MatchString("/other/hello") {
+		t.Fatal("pattern should not match a different prefix")
+	}
+}
+
+func TestCompileRoutePatternMultipleParams(t *testing.T) {
+	pattern, names := compileRoutePattern("/widgets/{id}/parts/{partID}")
+
+	if len(names) != 2 || names[0] != "id" || names[1] != "partID" {
+		t.Fatalf("names = %v, want [id partID]", names)
+	}
+
+	match := pattern.FindStringSubmatch("/widgets/42/parts/7")
+	if match == nil {
+		t.Fatal("expected match")
+	}
+	if match[1] != "42" || match[2] != "7" {
+		t.Fatalf("captures = %v, want [42 7]", match[1:])
+	}
+}
+
+func TestCompileRoutePatternNoParams(t *testing.T) {
+	pattern, names := compileRoutePattern("/count")
+
+	if len(names) != 0 {
+		t.Fatalf("names = %v, want none", names)
+	}
+	if !pattern.MatchString("/count") {
+		t.Fatal("expected /count to match its own static pattern")
+	}
+}
+
+func TestDispatchRoutes(t *testing.T) {
+	original := routeTable
+	t.Cleanup(func() {
+		routeMu.Lock()
+		routeTable = original
+		routeMu.Unlock()
+	})
+
+	pattern, names := compileRoutePattern("/uppercase/{s}")
+	var gotParams map[string]string
+	entry := routeEntry{
+		methods: map[string]struct{}{http.MethodGet: {}},
+		pattern: pattern,
+		names:   names,
+		handler: func(w http.ResponseWriter, r *http.Request) {
+			gotParams = PathParams(r.Context())
+			w.WriteHeader(http.StatusOK)
+		},
+	}
+
+	routeMu.Lock()
+	routeTable = []routeEntry{entry}
+	routeMu.Unlock()
+
+	req := httptest.NewRequest(http.MethodGet, "/uppercase/hello", nil)
+	rec := httptest.NewRecorder()
+	dispatchRoutes(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if gotParams["s"] != "hello" {
+		t.Fatalf("PathParams = %v, want s=hello", gotParams)
+	}
+}
+
+func TestDispatchRoutesMethodMismatch(t *testing.T) {
+	original := routeTable
+	t.Cleanup(func() {
+		routeMu.Lock()
+		routeTable = original
+		routeMu.Unlock()
+	})
+
+	pattern, names := compileRoutePattern("/uppercase/{s}")
+	entry := routeEntry{
+		methods: map[string]struct{}{http.MethodGet: {}},
+		pattern: pattern,
+		names:   names,
+		handler: func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		},
+	}
+
+	routeMu.Lock()
+	routeTable = []routeEntry{entry}
+	routeMu.Unlock()
+
+	req := httptest.NewRequest(http.MethodPost, "/uppercase/hello", nil)
+	rec := httptest.NewRecorder()
+	dispatchRoutes(rec, req)
+
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("status = %d, want %d for a method the route doesn't answer to", rec.Code, http.StatusNotFound)
+	}
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// RequestFunc is executed on the HTTP request before it reaches the decoder,
+// so values such as request IDs, auth tokens, or trace headers can be pulled
+// off r and placed into the context the endpoint sees.
+type RequestFunc func(context.Context, *http.Request) context.Context
+
+// ResponseFunc is executed after the endpoint, before the response is
+// encoded, so headers can be set on w ahead of the body being written.
+type ResponseFunc func(context.Context, http.ResponseWriter) context.Context
+
+// ErrorEncoder writes err to w. createHttpHandler and createRoutedHandler use
+// defaultErrorEncoder unless ServerErrorEncoder sets another one.
+type ErrorEncoder func(context.Context, error, http.ResponseWriter)
+
+// ServerOption customizes a handler built by createHttpHandler.
+type ServerOption func(*serverOptions)
+
+type serverOptions struct {
+	before       []RequestFunc
+	after        []ResponseFunc
+	errorEncoder ErrorEncoder
+}
+
+// ServerBefore registers one or more RequestFuncs that run, in order, before
+// the request is decoded.
+func ServerBefore(before ...RequestFunc) ServerOption {
+	return func(o *serverOptions) { o.before = append(o.before, before...) }
+}
+
+// ServerAfter registers one or more ResponseFuncs that run, in order, after
+// the endpoint returns but before the response is encoded.
+func ServerAfter(after ...ResponseFunc) ServerOption {
+	return func(o *serverOptions) { o.after = append(o.after, after...) }
+}
+
+// ServerErrorEncoder overrides how decode/endpoint/encode errors are written
+// to the client.
+func ServerErrorEncoder(enc ErrorEncoder) ServerOption {
+	return func(o *serverOptions) { o.errorEncoder = enc }
+}
+
+// defaultErrorEncoder writes a JSON body and a 500 status, unlike the
+// plain-text "err: " + 200 OK this handler used to send on every failure.
+func defaultErrorEncoder(_ context.Context, err error, w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusInternalServerError)
+	json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+}
+
+// serveEndpoint wires a decode -> endpoint -> encode pipeline, plus its
+// before/after hooks and error encoder, into a single http.HandlerFunc. Both
+// createHttpHandler and createRoutedHandler build their handler this way.
+func serveEndpoint[Req any, Resp any](options serverOptions, e Endpoint[Req, Resp],
+	dec DecodeRequestFunc[Req],
+	enc EncodeResponseFunc[Resp]) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		for _, f := range options.before {
+			ctx = f(ctx, r)
+		}
+
+		request, err := dec(ctx, r)
+		if err != nil {
+			options.errorEncoder(ctx, err, w)
+			return
+		}
+
+		response, err := e(ctx, request)
+		if err != nil {
+			options.errorEncoder(ctx, err, w)
+			return
+		}
+
+		for _, f := range options.after {
+			ctx = f(ctx, w)
+		}
+
+		if writeErr := enc(ctx, w, response); writeErr != nil {
+			options.errorEncoder(ctx, writeErr, w)
+			return
+		}
+	}
+}
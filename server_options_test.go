@@ -0,0 +1,118 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestServeEndpointDefaultErrorEncoderReturns500JSON(t *testing.T) {
+	options := serverOptions{errorEncoder: defaultErrorEncoder}
+	handler := serveEndpoint[countRequest, countResponse](options,
+		func(_ context.Context, _ countRequest) (countResponse, error) {
+			return countResponse{}, ErrEmpty
+		},
+		decodeCountRequest,
+		encodeCountResponse,
+	)
+
+	req := httptest.NewRequest(http.MethodPost, "/count", strings.NewReader(`{"s":"hi"}`))
+	rr := httptest.NewRecorder()
+	handler(rr, req)
+
+	if rr.Code != http.StatusInternalServerError {
+		t.Fatalf("status = %d, want %d (the old behavior wrote 200 OK on every failure)", rr.Code, http.StatusInternalServerError)
+	}
+
+	var body map[string]string
+	if err := json.Unmarshal(rr.Body.Bytes(), &body); err != nil {
+		t.Fatalf("body = %q, want JSON: %v", rr.Body.String(), err)
+	}
+	if body["error"] != ErrEmpty.Error() {
+		t.Fatalf("body[error] = %q, want %q", body["error"], ErrEmpty.Error())
+	}
+}
+
+func TestServeEndpointServerBeforeRunsBeforeDecode(t *testing.T) {
+	type ctxKey struct{}
+	options := serverOptions{errorEncoder: defaultErrorEncoder}
+	ServerBefore(func(ctx context.Context, _ *http.Request) context.Context {
+		return context.WithValue(ctx, ctxKey{}, "injected")
+	})(&options)
+
+	var sawInDecode, sawValue string
+	handler := serveEndpoint[countRequest, countResponse](options,
+		func(ctx context.Context, _ countRequest) (countResponse, error) {
+			sawValue, _ = ctx.Value(ctxKey{}).(string)
+			return countResponse{}, nil
+		},
+		func(ctx context.Context, r *http.Request) (countRequest, error) {
+			sawInDecode, _ = ctx.Value(ctxKey{}).(string)
+			return decodeCountRequest(ctx, r)
+		},
+		encodeCountResponse,
+	)
+
+	req := httptest.NewRequest(http.MethodPost, "/count", strings.NewReader(`{"s":"hi"}`))
+	handler(httptest.NewRecorder(), req)
+
+	if sawInDecode != "injected" {
+		t.Fatalf("sawInDecode = %q, want %q", sawInDecode, "injected")
+	}
+	if sawValue != "injected" {
+		t.Fatalf("sawValue = %q, want %q", sawValue, "injected")
+	}
+}
+
+func TestServeEndpointServerAfterRunsBeforeEncode(t *testing.T) {
+	options := serverOptions{errorEncoder: defaultErrorEncoder}
+	ServerAfter(func(ctx context.Context, w http.ResponseWriter) context.Context {
+		w.Header().Set("X-From-After", "yes")
+		return ctx
+	})(&options)
+
+	handler := serveEndpoint[countRequest, countResponse](options,
+		func(_ context.Context, _ countRequest) (countResponse, error) {
+			return countResponse{}, nil
+		},
+		decodeCountRequest,
+		encodeCountResponse,
+	)
+
+	req := httptest.NewRequest(http.MethodPost, "/count", strings.NewReader(`{"s":"hi"}`))
+	rr := httptest.NewRecorder()
+	handler(rr, req)
+
+	// Result().Header is the header as it stood when the body was first
+	// written, so this only passes if the hook ran before the encoder.
+	if rr.Result().Header.Get("X-From-After") != "yes" {
+		t.Fatalf("X-From-After header missing, want it set by the ServerAfter hook")
+	}
+}
+
+func TestServeEndpointServerErrorEncoderOverridesDefault(t *testing.T) {
+	options := serverOptions{errorEncoder: defaultErrorEncoder}
+	ServerErrorEncoder(func(_ context.Context, err error, w http.ResponseWriter) {
+		w.WriteHeader(http.StatusTeapot)
+	})(&options)
+
+	handler := serveEndpoint[countRequest, countResponse](options,
+		func(_ context.Context, _ countRequest) (countResponse, error) {
+			return countResponse{}, errors.New("boom")
+		},
+		decodeCountRequest,
+		encodeCountResponse,
+	)
+
+	req := httptest.NewRequest(http.MethodPost, "/count", nil)
+	rr := httptest.NewRecorder()
+	handler(rr, req)
+
+	if rr.Code != http.StatusTeapot {
+		t.Fatalf("status = %d, want %d", rr.Code, http.StatusTeapot)
+	}
+}
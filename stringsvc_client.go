@@ -0,0 +1,68 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+)
+
+// StringServiceEndpoints collects the client-side Endpoints for
+// StringService, so a caller can chain middlewares onto each one before
+// invoking it, same as on the server.
+type StringServiceEndpoints struct {
+	UppercaseEndpoint Endpoint[uppercaseRequest, uppercaseResponse]
+	CountEndpoint     Endpoint[countRequest, countResponse]
+}
+
+// MakeClientEndpoints returns a StringServiceEndpoints that talks to the
+// StringService HTTP handlers exposed at baseURL.
+func MakeClientEndpoints(baseURL string, opts ...ClientOption) StringServiceEndpoints {
+	return StringServiceEndpoints{
+		UppercaseEndpoint: NewHTTPClient[uppercaseRequest, uppercaseResponse](
+			http.MethodPost, baseURL+"/uppercase",
+			encodeUppercaseHTTPRequest,
+			decodeUppercaseHTTPResponse,
+			opts...,
+		),
+		CountEndpoint: NewHTTPClient[countRequest, countResponse](
+			http.MethodPost, baseURL+"/count",
+			encodeCountHTTPRequest,
+			decodeCountHTTPResponse,
+			opts...,
+		),
+	}
+}
+
+func encodeUppercaseHTTPRequest(_ context.Context, r *http.Request, request uppercaseRequest) error {
+	return encodeJSONRequestBody(r, request)
+}
+
+func decodeUppercaseHTTPResponse(_ context.Context, r *http.Response) (uppercaseResponse, error) {
+	var response uppercaseResponse
+	err := json.NewDecoder(r.Body).Decode(&response)
+	return response, err
+}
+
+func encodeCountHTTPRequest(_ context.Context, r *http.Request, request countRequest) error {
+	return encodeJSONRequestBody(r, request)
+}
+
+func decodeCountHTTPResponse(_ context.Context, r *http.Response) (countResponse, error) {
+	var response countResponse
+	err := json.NewDecoder(r.Body).Decode(&response)
+	return response, err
+}
+
+// encodeJSONRequestBody marshals v as the JSON body of r, the way
+// EncodeRequestFunc implementations are expected to populate r.Body.
+func encodeJSONRequestBody(r *http.Request, v any) error {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(v); err != nil {
+		return err
+	}
+	r.Body = io.NopCloser(&buf)
+	r.ContentLength = int64(buf.Len())
+	return nil
+}